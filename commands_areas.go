@@ -0,0 +1,37 @@
+package main
+
+import (
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var areasCommand = &cli.Command{
+	Name:  "areas",
+	Usage: "operate on areas",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "list areas",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "active", Usage: "only list active areas"},
+				&cli.IntFlag{Name: "max", Usage: "stop after this many areas (0 for unbounded)", Value: 1000},
+			},
+			Action: func(ctx *cli.Context) error {
+				filter := dispatch.Filter{}
+				if ctx.Bool("active") {
+					filter["active"] = "true"
+				}
+
+				areas, err := dispatch.ListAll(client(ctx).IterateAreas(ctx.Context, filter), ctx.Int("max"))
+				if err != nil {
+					return err
+				}
+
+				for _, a := range areas {
+					logf(ctx, a.Code, a)
+				}
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var dispatchesCommand = &cli.Command{
+	Name:  "dispatches",
+	Usage: "operate on dispatches",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "open",
+			Usage: "open a new dispatch against a machine",
+			Flags: []cli.Flag{
+				&cli.IntFlag{Name: "dispatchtype-id", Required: true},
+				&cli.IntFlag{Name: "machine-id", Required: true},
+				&cli.StringFlag{Name: "description", Required: true},
+				&cli.TimestampFlag{Name: "start", Layout: dispatch.MinuteFormat},
+				&cli.TimestampFlag{Name: "end", Layout: dispatch.MinuteFormat},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.OpenDispatchParams{
+					DispatchTypeId: ctx.Int("dispatchtype-id"),
+					MachineId:      ctx.Int("machine-id"),
+					Description:    ctx.String("description"),
+				}
+				if t := ctx.Timestamp("start"); t != nil {
+					params.Start = *t
+				}
+				if t := ctx.Timestamp("end"); t != nil {
+					params.End = *t
+				}
+
+				d, err := client(ctx).OpenDispatch(ctx.Context, params)
+				if err != nil {
+					return err
+				}
+				logf(ctx, "Opened dispatch "+strconv.Itoa(d.Id), d)
+				return nil
+			},
+		},
+		{
+			Name:  "close",
+			Usage: "close a previously opened dispatch",
+			Flags: []cli.Flag{
+				&cli.IntFlag{Name: "id", Required: true},
+			},
+			Action: func(ctx *cli.Context) error {
+				d, err := client(ctx).CloseDispatch(ctx.Context, ctx.Int("id"))
+				if err != nil {
+					return err
+				}
+				logf(ctx, "Closed dispatch "+strconv.Itoa(d.Id), d)
+				return nil
+			},
+		},
+		{
+			Name:  "add",
+			Usage: "record a dispatch that already happened",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "dispatchtype-code", Required: true},
+				&cli.StringFlag{Name: "machine-code", Required: true},
+				&cli.StringFlag{Name: "description", Required: true},
+				&cli.TimestampFlag{Name: "reported", Layout: dispatch.MinuteFormat, Required: true},
+				&cli.TimestampFlag{Name: "completed", Layout: dispatch.MinuteFormat, Required: true},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.AddDispatchParams{
+					DispatchTypeCode: ctx.String("dispatchtype-code"),
+					MachineCode:      ctx.String("machine-code"),
+					Description:      ctx.String("description"),
+					Reported:         *ctx.Timestamp("reported"),
+					Completed:        *ctx.Timestamp("completed"),
+				}
+
+				d, err := client(ctx).AddDispatch(ctx.Context, params)
+				if err != nil {
+					return err
+				}
+				logf(ctx, "Recorded dispatch "+strconv.Itoa(d.Id), d)
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var machinesCommand = &cli.Command{
+	Name:  "machines",
+	Usage: "operate on machines",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "set-cycle-count",
+			Usage: "set a machine's cycle count to an absolute value",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "code", Usage: "machine code", Required: true},
+				&cli.IntFlag{Name: "count", Usage: "cycle count to set", Required: true},
+			},
+			Action: func(ctx *cli.Context) error {
+				if err := client(ctx).SetCycleCount(ctx.Context, ctx.String("code"), ctx.Int("count")); err != nil {
+					return err
+				}
+				logf(ctx, "Set machine cycle count", nil)
+				return nil
+			},
+		},
+		{
+			Name:  "increment-cycle-count",
+			Usage: "add to a machine's current cycle count",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "code", Usage: "machine code", Required: true},
+				&cli.IntFlag{Name: "by", Usage: "amount to add", Required: true},
+				&cli.BoolFlag{Name: "skip-lastupdated", Usage: "skip updating the machine's lastupdated timestamp"},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.IncrementCycleCountParams{
+					By:              ctx.Int("by"),
+					SkipLastUpdated: ctx.Bool("skip-lastupdated"),
+				}
+				if err := client(ctx).IncrementCycleCount(ctx.Context, ctx.String("code"), params); err != nil {
+					return err
+				}
+				logf(ctx, "Incremented machine cycle count", nil)
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var pitchdetailsCommand = &cli.Command{
+	Name:  "pitchdetails",
+	Usage: "operate on pitch details (production data)",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "record",
+			Usage: "record production data for a pitch on a line",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "line", Usage: "line code", Required: true},
+				&cli.StringFlag{Name: "product", Usage: "product code", Required: true},
+				&cli.IntFlag{Name: "actual", Required: true},
+				&cli.IntFlag{Name: "scrap", Required: true},
+				&cli.IntFlag{Name: "operator-count"},
+				&cli.StringFlag{Name: "start", Usage: `start of the pitch, e.g. "2023-01-02 15:04" or "now"`, Value: "now"},
+				&cli.StringFlag{Name: "end", Usage: `end of the pitch, e.g. "2023-01-02 15:04" or "now"`, Value: "now"},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.RecordPitchDetailsParams{
+					LineCode:      ctx.String("line"),
+					ProductCode:   ctx.String("product"),
+					Actual:        ctx.Int("actual"),
+					Scrap:         ctx.Int("scrap"),
+					OperatorCount: ctx.Int("operator-count"),
+					Start:         ctx.String("start"),
+					End:           ctx.String("end"),
+				}
+
+				pd, err := client(ctx).RecordPitchDetails(ctx.Context, params)
+				if err != nil {
+					return err
+				}
+				logf(ctx, "Recorded pitch details", pd)
+				return nil
+			},
+		},
+		{
+			Name:  "get",
+			Usage: "retrieve the production reporting data for a line over a date range",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "line", Usage: "line code", Required: true},
+				&cli.StringFlag{Name: "product", Usage: "product code"},
+				&cli.TimestampFlag{Name: "start", Layout: dispatch.SecondsFormat, Required: true},
+				&cli.TimestampFlag{Name: "end", Layout: dispatch.SecondsFormat, Required: true},
+				&cli.BoolFlag{Name: "show-products"},
+			},
+			Action: func(ctx *cli.Context) error {
+				filter := dispatch.Filter{
+					"linecode": ctx.String("line"),
+					"start":    ctx.Timestamp("start").Format(dispatch.SecondsFormat),
+					"end":      ctx.Timestamp("end").Format(dispatch.SecondsFormat),
+				}
+				if v := ctx.String("product"); v != "" {
+					filter["productcode"] = v
+				}
+				if ctx.Bool("show-products") {
+					filter["show_products"] = "true"
+				}
+
+				summary, err := client(ctx).GetPitchDetails(ctx.Context, filter)
+				if err != nil {
+					return err
+				}
+				logf(ctx, "Retrieved daily summary for line", summary)
+				return nil
+			},
+		},
+	},
+}
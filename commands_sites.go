@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var sitesCommand = &cli.Command{
+	Name:  "sites",
+	Usage: "operate on sites",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "list sites",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "active", Usage: "only list active sites"},
+			},
+			Action: func(ctx *cli.Context) error {
+				filter := dispatch.Filter{}
+				if ctx.Bool("active") {
+					filter["active"] = "true"
+				}
+
+				sites, err := client(ctx).ListSites(ctx.Context, filter)
+				if err != nil {
+					return err
+				}
+
+				for _, s := range sites {
+					logf(ctx, s.Code, s)
+				}
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/leading2lean/les-go/dispatch"
+	"github.com/urfave/cli/v2"
+)
+
+var usersCommand = &cli.Command{
+	Name:  "users",
+	Usage: "operate on user clock-in sessions",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "clock-in",
+			Usage: "clock a user in to a line",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "user", Required: true},
+				&cli.StringFlag{Name: "line", Usage: "line code", Required: true},
+				&cli.TimestampFlag{Name: "start", Layout: dispatch.MinuteFormat, Usage: "backdate the clock-in: session start"},
+				&cli.TimestampFlag{Name: "end", Layout: dispatch.MinuteFormat, Usage: "backdate the clock-in: session end"},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.ClockInParams{LineCode: ctx.String("line")}
+				if t := ctx.Timestamp("start"); t != nil {
+					params.Start = *t
+				}
+				if t := ctx.Timestamp("end"); t != nil {
+					params.End = *t
+				}
+
+				if err := client(ctx).ClockIn(ctx.Context, ctx.String("user"), params); err != nil {
+					return err
+				}
+				logf(ctx, "User clocked in", nil)
+				return nil
+			},
+		},
+		{
+			Name:  "clock-out",
+			Usage: "clock a user out",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "user", Required: true},
+				&cli.StringFlag{Name: "line", Usage: "line code", Required: true},
+			},
+			Action: func(ctx *cli.Context) error {
+				params := dispatch.ClockOutParams{LineCode: ctx.String("line")}
+				if err := client(ctx).ClockOut(ctx.Context, ctx.String("user"), params); err != nil {
+					return err
+				}
+				logf(ctx, "User clocked out", nil)
+				return nil
+			},
+		},
+	},
+}
@@ -0,0 +1,28 @@
+package dispatch
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListAreas returns the areas matching filter, e.g. Filter{"active": "true"}.
+// Results are not paginated automatically; callers that expect more than a
+// handful of areas should use IterateAreas instead.
+func (c *Client) ListAreas(ctx context.Context, filter Filter) ([]Area, error) {
+	var areas []Area
+	if err := c.get(ctx, "api/1.0/areas/", c.params(filter), &areas); err != nil {
+		return nil, err
+	}
+	return areas, nil
+}
+
+// IterateAreas pages through the areas matching filter, correctly advancing
+// "offset" on every page and stopping once a short page is returned.
+func (c *Client) IterateAreas(ctx context.Context, filter Filter) *Iterator[Area] {
+	return newIterator(ctx, defaultPageLimit, func(ctx context.Context, limit, offset int) ([]Area, error) {
+		page := cloneFilter(filter)
+		page["limit"] = strconv.Itoa(limit)
+		page["offset"] = strconv.Itoa(offset)
+		return c.ListAreas(ctx, page)
+	})
+}
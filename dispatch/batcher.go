@@ -0,0 +1,295 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Default tuning for a CycleCountBatcher created without the corresponding
+// BatcherOption.
+const (
+	DefaultBatchFlushInterval    = 250 * time.Millisecond
+	DefaultBatchMachineThreshold = 1000
+)
+
+// drainPollInterval is the starting interval at which Close's final drain
+// re-attempts a flush for machines that failed on the previous attempt. It
+// doubles on every miss, up to maxDrainPollInterval, so a drain that drags
+// on because the API is down doesn't hammer it at a fixed fast rate.
+const (
+	drainPollInterval    = 20 * time.Millisecond
+	maxDrainPollInterval = 2 * time.Second
+)
+
+// CycleCountBatcher coalesces many small Increment calls for high frequency
+// machines (e.g. PLC-fed telemetry) into periodic calls to
+// api/1.0/machines/increment_cycle_count/, instead of one HTTP round trip
+// per increment.
+type CycleCountBatcher struct {
+	client    *Client
+	interval  time.Duration
+	threshold int
+
+	mu           sync.Mutex
+	pending      map[string]int
+	inflight     map[string]bool
+	backoffUntil map[string]time.Time
+	failCount    map[string]int
+	closed       bool
+
+	flushWake chan struct{}
+	stopCh    chan struct{}
+	drainCtx  context.Context
+	wg        sync.WaitGroup
+
+	sent    atomic.Uint64
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+}
+
+// BatcherOption configures a CycleCountBatcher created by NewCycleCountBatcher.
+type BatcherOption func(*CycleCountBatcher)
+
+// WithBatchFlushInterval sets how often pending increments are flushed, on
+// top of the per-machine threshold flush. The default is DefaultBatchFlushInterval.
+func WithBatchFlushInterval(d time.Duration) BatcherOption {
+	return func(b *CycleCountBatcher) {
+		b.interval = d
+	}
+}
+
+// WithBatchMachineThreshold sets the accumulated increment amount, per
+// machine, that triggers an immediate flush instead of waiting for the next
+// interval tick. The default is DefaultBatchMachineThreshold.
+func WithBatchMachineThreshold(n int) BatcherOption {
+	return func(b *CycleCountBatcher) {
+		b.threshold = n
+	}
+}
+
+// NewCycleCountBatcher returns a CycleCountBatcher that sends increments
+// through client. Call Close when done to flush any outstanding increments.
+func NewCycleCountBatcher(client *Client, opts ...BatcherOption) *CycleCountBatcher {
+	b := &CycleCountBatcher{
+		client:       client,
+		interval:     DefaultBatchFlushInterval,
+		threshold:    DefaultBatchMachineThreshold,
+		pending:      make(map[string]int),
+		inflight:     make(map[string]bool),
+		backoffUntil: make(map[string]time.Time),
+		failCount:    make(map[string]int),
+		flushWake:    make(chan struct{}, 1),
+		stopCh:       make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	b.wg.Add(1)
+	go b.run()
+
+	return b
+}
+
+// Increment adds n to machineCode's pending cycle count. It is cheap and
+// safe to call from many goroutines, e.g. once per PLC-reported cycle.
+// Increments made after Close has started are dropped.
+func (b *CycleCountBatcher) Increment(machineCode string, n int) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		b.dropped.Add(1)
+		return
+	}
+
+	b.pending[machineCode] += n
+	wake := b.pending[machineCode] >= b.threshold
+	b.mu.Unlock()
+
+	if wake {
+		select {
+		case b.flushWake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// BatcherStats reports cumulative counters for a CycleCountBatcher.
+type BatcherStats struct {
+	// Sent is the number of successful flush calls to the API.
+	Sent uint64
+	// Dropped is the number of Increment calls made after Close started.
+	Dropped uint64
+	// Failed is the number of flush calls that returned an error and were
+	// re-queued for a later attempt.
+	Failed uint64
+}
+
+// Stats returns the batcher's cumulative counters.
+func (b *CycleCountBatcher) Stats() BatcherStats {
+	return BatcherStats{
+		Sent:    b.sent.Load(),
+		Dropped: b.dropped.Load(),
+		Failed:  b.failed.Load(),
+	}
+}
+
+// Close stops accepting new increments, then repeatedly flushes outstanding
+// ones - bypassing backoff - until every machine has been sent or ctx is
+// done, whichever comes first. A non-nil error means ctx ran out before
+// every pending increment was confirmed sent; Stats() still reports what
+// did get through.
+func (b *CycleCountBatcher) Close(ctx context.Context) error {
+	b.mu.Lock()
+	alreadyClosed := b.closed
+	b.closed = true
+	if !alreadyClosed {
+		b.drainCtx = ctx
+	}
+	b.mu.Unlock()
+
+	if !alreadyClosed {
+		close(b.stopCh)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		b.mu.Lock()
+		remaining := len(b.pending) + len(b.inflight)
+		b.mu.Unlock()
+		if remaining > 0 {
+			// The drain gave up (drainCtx - from whichever call first
+			// closed stopCh - ran out) with machines still outstanding.
+			// A later Close call may be passed a ctx that hasn't expired
+			// yet, so ctx.Err() alone isn't a reliable signal here.
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return fmt.Errorf("dispatch: batcher closed with %d machine(s) still pending", remaining)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *CycleCountBatcher) run() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.flushAll(false)
+		case <-b.flushWake:
+			b.flushAll(false)
+		case <-b.stopCh:
+			// Shutting down: keep retrying every machine, ignoring any
+			// backoff still in effect, until nothing is left pending or
+			// in flight, or the context passed to Close gives up. A single
+			// attempt here would silently drop counts that fail on the
+			// last flush before shutdown.
+			b.drain()
+			return
+		}
+	}
+}
+
+// drain repeatedly flushes every outstanding machine, bypassing backoff,
+// until none remain pending or in flight, or b.drainCtx is done. Close
+// waits on b.wg, which doesn't clear until drain (and thus run) returns, so
+// this is what makes Close's wait actually mean "nothing left behind".
+func (b *CycleCountBatcher) drain() {
+	interval := drainPollInterval
+
+	for {
+		b.flushAll(true)
+
+		b.mu.Lock()
+		remaining := len(b.pending) + len(b.inflight)
+		b.mu.Unlock()
+		if remaining == 0 {
+			return
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-b.drainCtx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > maxDrainPollInterval {
+			interval = maxDrainPollInterval
+		}
+	}
+}
+
+// flushAll starts a flushOne goroutine for every machine with a
+// non-backed-off pending increment. force bypasses backoff, for the final
+// drain on Close.
+func (b *CycleCountBatcher) flushAll(force bool) {
+	b.mu.Lock()
+	now := time.Now()
+	batches := make(map[string]int)
+	for code, amt := range b.pending {
+		if amt == 0 || b.inflight[code] {
+			continue
+		}
+		if !force && now.Before(b.backoffUntil[code]) {
+			continue
+		}
+		batches[code] = amt
+		delete(b.pending, code)
+		b.inflight[code] = true
+	}
+	b.mu.Unlock()
+
+	for code, amt := range batches {
+		b.wg.Add(1)
+		go b.flushOne(code, amt)
+	}
+}
+
+// flushOne sends machineCode's accumulated increment. Only one flushOne
+// per machine code is ever in flight at a time, which keeps increments for
+// that machine applied in the order they were coalesced.
+func (b *CycleCountBatcher) flushOne(machineCode string, amount int) {
+	defer b.wg.Done()
+
+	err := b.client.IncrementCycleCount(context.Background(), machineCode, IncrementCycleCountParams{
+		By:              amount,
+		SkipLastUpdated: true,
+	})
+
+	b.mu.Lock()
+	delete(b.inflight, machineCode)
+	if err != nil {
+		b.pending[machineCode] += amount
+		b.failCount[machineCode]++
+		b.backoffUntil[machineCode] = time.Now().Add(backoff(b.client.retryInterval, b.failCount[machineCode]))
+	} else {
+		b.failCount[machineCode] = 0
+		delete(b.backoffUntil, machineCode)
+	}
+	b.mu.Unlock()
+
+	if err != nil {
+		b.failed.Add(1)
+	} else {
+		b.sent.Add(1)
+	}
+}
@@ -0,0 +1,80 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCycleCountBatcherCloseDoesNotDropOnEventualSuccess(t *testing.T) {
+	var calls atomic.Int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+
+	b := NewCycleCountBatcher(client, WithBatchFlushInterval(time.Hour))
+	b.Increment("M1", 5)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := b.Close(ctx); err != nil {
+		t.Fatalf("Close() = %v, want nil once the server starts succeeding", err)
+	}
+
+	stats := b.Stats()
+	if stats.Sent != 1 {
+		t.Errorf("Stats().Sent = %d, want 1", stats.Sent)
+	}
+	if calls.Load() < 3 {
+		t.Errorf("server got %d calls, want at least 3 (drain must retry past the first two failures)", calls.Load())
+	}
+}
+
+func TestCycleCountBatcherCloseReportsErrorWhenStillFailing(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	b := NewCycleCountBatcher(client, WithBatchFlushInterval(time.Hour))
+	b.Increment("M1", 5)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := b.Close(ctx); err == nil {
+		t.Fatal("Close() = nil, want an error: the increment was never sent and must not be reported as clean shutdown")
+	}
+
+	stats := b.Stats()
+	if stats.Sent != 0 {
+		t.Errorf("Stats().Sent = %d, want 0", stats.Sent)
+	}
+}
+
+func TestCycleCountBatcherSecondCloseDoesNotMaskFailure(t *testing.T) {
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	b := NewCycleCountBatcher(client, WithBatchFlushInterval(time.Hour))
+	b.Increment("M1", 5)
+
+	shortCtx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := b.Close(shortCtx); err == nil {
+		t.Fatal("first Close() = nil, want an error")
+	}
+
+	// A second Close, with a ctx that hasn't expired, must not report
+	// success just because the (already-given-up) drain goroutine is idle.
+	freshCtx, cancel2 := context.WithTimeout(context.Background(), time.Second)
+	defer cancel2()
+	if err := b.Close(freshCtx); err == nil {
+		t.Fatal("second Close() = nil, want an error: the increment is still stuck in pending")
+	}
+}
@@ -0,0 +1,277 @@
+// Package dispatch provides a typed Go client for the L2L Dispatch API.
+//
+// It replaces the copy-paste pattern of hand-building url.Values and
+// checking `success`/`error` on every call with a single Client that
+// callers construct once via NewClient and reuse for every request.
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// These are the standard datetime string formats that the Dispatch API supports.
+const (
+	MinuteFormat  = "2006-01-02 15:04"
+	SecondsFormat = "2006-01-02 15:04:05"
+)
+
+// Client talks to the L2L Dispatch API for a single site using a single API key.
+type Client struct {
+	server string
+	apikey string
+	site   string
+
+	httpClient *http.Client
+
+	maxAttempts   int
+	retryInterval time.Duration
+	retryTimeout  time.Duration
+	onRetry       func(attempt int, err error, next time.Duration)
+}
+
+// Option configures a Client created by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to make requests. This is
+// useful for tests, or for callers that need custom transports (proxies,
+// TLS settings, etc).
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// NewClient returns a Client that authenticates as apikey against site on
+// server (a bare hostname, e.g. "dispatch.leading2lean.com").
+func NewClient(server, apikey, site string, opts ...Option) *Client {
+	c := &Client{
+		server:        server,
+		apikey:        apikey,
+		site:          site,
+		httpClient:    http.DefaultClient,
+		maxAttempts:   DefaultMaxAttempts,
+		retryInterval: DefaultRetryInterval,
+		retryTimeout:  DefaultRetryTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// APIError is returned when the Dispatch API responds with success=false,
+// or with a non-2xx HTTP status.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response, or 0 if the
+	// request never reached the server (success=false with a 200).
+	StatusCode int
+	// Message is the API's "error" field, or the HTTP status text.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode != 0 && e.StatusCode != http.StatusOK {
+		return fmt.Sprintf("dispatch: http %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("dispatch: %s", e.Message)
+}
+
+// envelope is the common shape of every Dispatch API response: a success
+// flag, an error message when success is false, and the endpoint-specific
+// payload in Data.
+type envelope struct {
+	Success bool            `json:"success"`
+	Error   string          `json:"error"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (c *Client) baseURL(path string) *url.URL {
+	return &url.URL{
+		Scheme: "https",
+		Host:   c.server,
+		Path:   path,
+	}
+}
+
+// params returns a url.Values pre-populated with auth and site, plus
+// whatever extra parameters the caller supplies.
+func (c *Client) params(extra map[string]string) url.Values {
+	v := url.Values{}
+	v.Add("auth", c.apikey)
+	v.Add("site", c.site)
+
+	for k, val := range extra {
+		v.Add(k, val)
+	}
+
+	return v
+}
+
+// get issues a GET request against path with the given query parameters and
+// decodes the envelope's Data field into out. GET requests are always
+// retryable, since they're idempotent by construction.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	u := c.baseURL(path)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("dispatch: building request: %w", err)
+	}
+
+	return c.do(ctx, req, out, true)
+}
+
+// post issues a form-encoded POST request against path and decodes the
+// envelope's Data field into out. out may be nil when the caller doesn't
+// care about the response payload. POSTs are not idempotent in general, so
+// they are only retried when the caller passes AllowRetry().
+func (c *Client) post(ctx context.Context, path string, form url.Values, out interface{}, opts ...requestOption) error {
+	u := c.baseURL(path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBufferString(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("dispatch: building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var rc requestConfig
+	for _, opt := range opts {
+		opt(&rc)
+	}
+
+	return c.do(ctx, req, out, rc.retryable)
+}
+
+// do sends req, retrying on network errors, 5xx and 429 responses when
+// retryable is true, up to c.maxAttempts attempts or c.retryTimeout,
+// whichever comes first. c.retryTimeout bounds every individual attempt as
+// well as the time between them, so a single hung connection can't block
+// past the deadline regardless of how long httpClient would otherwise wait.
+func (c *Client) do(ctx context.Context, req *http.Request, out interface{}, retryable bool) error {
+	deadline := time.Now().Add(c.retryTimeout)
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, time.Until(deadline))
+		body, status, retryHint, err := c.attempt(req.WithContext(attemptCtx))
+		cancel()
+		if err == nil {
+			return c.decode(body, out)
+		}
+		lastErr = err
+
+		if !retryable || attempt >= c.maxAttempts || !isRetryable(status, err) {
+			return err
+		}
+
+		wait := backoff(c.retryInterval, attempt)
+		if retryHint > 0 {
+			wait = retryHint
+		}
+		if time.Now().Add(wait).After(deadline) {
+			return lastErr
+		}
+
+		if c.onRetry != nil {
+			c.onRetry(attempt, err, wait)
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+
+		if req.GetBody != nil {
+			newBody, err := req.GetBody()
+			if err != nil {
+				return fmt.Errorf("dispatch: rewinding request body for retry: %w", err)
+			}
+			req.Body = newBody
+		}
+	}
+}
+
+// attempt performs a single HTTP round trip, returning the raw response
+// body, status code (0 for transport errors), a Retry-After hint (0 if
+// absent), and an error describing any non-success outcome (transport
+// error, non-200 status, or success=false).
+func (c *Client) attempt(req *http.Request) ([]byte, int, time.Duration, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("dispatch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	retryHint, _ := retryAfter(resp)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, retryHint, fmt.Errorf("dispatch: reading response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return body, resp.StatusCode, retryHint, &APIError{StatusCode: resp.StatusCode, Message: resp.Status}
+	}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return body, resp.StatusCode, retryHint, fmt.Errorf("dispatch: decoding response: %w", err)
+	}
+	if !env.Success {
+		return body, resp.StatusCode, retryHint, &APIError{Message: env.Error}
+	}
+
+	return body, resp.StatusCode, retryHint, nil
+}
+
+// decode unmarshals a successful response's envelope.Data into out.
+func (c *Client) decode(body []byte, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return fmt.Errorf("dispatch: decoding response: %w", err)
+	}
+	if len(env.Data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("dispatch: decoding data: %w", err)
+	}
+
+	return nil
+}
+
+// isRetryable reports whether a failed attempt is worth retrying: a
+// transport-level error (status == 0), or a 5xx/429 response.
+func isRetryable(status int, err error) bool {
+	if status == 0 {
+		return err != nil
+	}
+	return isRetryableStatus(status)
+}
+
+// sleep waits for d, or returns ctx.Err() if ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,170 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pinnedTransport redirects every request to target, regardless of the
+// scheme/host the Client built (it always builds https://<server>/...),
+// so a plain httptest.NewServer can stand in for the Dispatch API in tests.
+type pinnedTransport struct {
+	target *url.URL
+}
+
+func (t pinnedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// testClient returns a Client whose requests are redirected to a
+// httptest.NewServer running handler. opts are applied after the test
+// defaults (a pinned transport and a single attempt), so callers can
+// override retry tuning.
+func testClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parsing test server URL: %v", err)
+	}
+
+	base := []Option{
+		WithHTTPClient(&http.Client{Transport: pinnedTransport{target: target}}),
+		WithMaxAttempts(1),
+	}
+	return NewClient("example.invalid", "testkey", "SITE1", append(base, opts...)...)
+}
+
+func TestClientGetRetriesOn5xxThenSucceeds(t *testing.T) {
+	var calls atomic.Int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"code":"SITE1"}}`))
+	}), WithMaxAttempts(5), WithRetryInterval(time.Millisecond))
+
+	var out Site
+	err := client.get(context.Background(), "api/1.0/sites/", nil, &out)
+	if err != nil {
+		t.Fatalf("get() = %v, want nil", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("server got %d calls, want 3", calls.Load())
+	}
+	if out.Code != "SITE1" {
+		t.Errorf("out.Code = %q, want SITE1", out.Code)
+	}
+}
+
+func TestClientGetStopsOnNonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}), WithMaxAttempts(5), WithRetryInterval(time.Millisecond))
+
+	err := client.get(context.Background(), "api/1.0/sites/", nil, nil)
+	if err == nil {
+		t.Fatal("get() = nil, want an error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("server got %d calls, want 1 (400 is not retryable)", calls.Load())
+	}
+}
+
+func TestClientPostDoesNotRetryWithoutAllowRetry(t *testing.T) {
+	var calls atomic.Int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}), WithMaxAttempts(5), WithRetryInterval(time.Millisecond))
+
+	err := client.post(context.Background(), "api/1.0/machines/increment_cycle_count/", url.Values{}, nil)
+	if err == nil {
+		t.Fatal("post() = nil, want an error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("server got %d calls, want 1 (POST without AllowRetry must not retry)", calls.Load())
+	}
+}
+
+func TestClientPostRetriesWithAllowRetry(t *testing.T) {
+	var calls atomic.Int32
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}), WithMaxAttempts(3), WithRetryInterval(time.Millisecond))
+
+	err := client.post(context.Background(), "api/1.0/machines/set_cycle_count/", url.Values{}, nil, AllowRetry())
+	if err != nil {
+		t.Fatalf("post() = %v, want nil", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("server got %d calls, want 2", calls.Load())
+	}
+}
+
+func TestClientHonorsRetryAfterHeader(t *testing.T) {
+	var calls atomic.Int32
+	var firstAttempt, secondAttempt time.Time
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		secondAttempt = time.Now()
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}), WithMaxAttempts(3), WithRetryInterval(time.Millisecond))
+
+	if err := client.get(context.Background(), "api/1.0/sites/", nil, nil); err != nil {
+		t.Fatalf("get() = %v, want nil", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("server got %d calls, want 2", calls.Load())
+	}
+	if wait := secondAttempt.Sub(firstAttempt); wait < 900*time.Millisecond {
+		t.Errorf("retry fired after %v, want it to honor the 1s Retry-After header", wait)
+	}
+}
+
+func TestClientBoundsEachAttemptByRetryTimeout(t *testing.T) {
+	block := make(chan struct{})
+
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Never respond, simulating a hung connection.
+		<-block
+	}), WithRetryTimeout(100*time.Millisecond), WithMaxAttempts(1))
+
+	// Registered after testClient's srv.Close cleanup, so it runs first
+	// (t.Cleanup is LIFO) and unblocks the handler before the server
+	// tries to shut down.
+	t.Cleanup(func() { close(block) })
+
+	start := time.Now()
+	err := client.get(context.Background(), "api/1.0/sites/", nil, nil)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("get() = nil, want an error once the attempt's deadline expires")
+	}
+	if elapsed > time.Second {
+		t.Errorf("get() took %v, want it bounded by RetryTimeout (100ms) rather than blocking on the hung connection", elapsed)
+	}
+}
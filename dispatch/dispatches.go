@@ -0,0 +1,79 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// OpenDispatchParams configures a call to OpenDispatch.
+type OpenDispatchParams struct {
+	DispatchTypeId int
+	MachineId      int
+	Description    string
+	// Start and End bound the period the Dispatch covers, in the site's
+	// local time. Both are optional; the API defaults to now.
+	Start, End time.Time
+}
+
+// OpenDispatch opens a new Dispatch against a machine, e.g. to record that
+// it needs intervention, and returns the created Dispatch's id.
+func (c *Client) OpenDispatch(ctx context.Context, params OpenDispatchParams) (*Dispatch, error) {
+	extra := map[string]string{
+		"dispatchtype": strconv.Itoa(params.DispatchTypeId),
+		"machine":      strconv.Itoa(params.MachineId),
+		"description":  params.Description,
+	}
+	if !params.Start.IsZero() {
+		extra["start"] = params.Start.Format(MinuteFormat)
+	}
+	if !params.End.IsZero() {
+		extra["end"] = params.End.Format(MinuteFormat)
+	}
+
+	var d Dispatch
+	if err := c.post(ctx, "api/1.0/dispatches/open/", c.params(extra), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// CloseDispatch closes a previously opened Dispatch by id.
+func (c *Client) CloseDispatch(ctx context.Context, id int) (*Dispatch, error) {
+	var d Dispatch
+	path := fmt.Sprintf("api/1.0/dispatches/close/%d/", id)
+	if err := c.post(ctx, path, c.params(nil), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// AddDispatchParams configures a call to AddDispatch.
+type AddDispatchParams struct {
+	DispatchTypeCode string
+	MachineCode      string
+	Description      string
+	// Reported and Completed record when the event happened, in the
+	// site's local time, for a Dispatch that is being logged after the fact.
+	Reported, Completed time.Time
+}
+
+// AddDispatch records a Dispatch that already happened and is being logged
+// retroactively, as opposed to OpenDispatch/CloseDispatch for one that is
+// tracked live.
+func (c *Client) AddDispatch(ctx context.Context, params AddDispatchParams) (*Dispatch, error) {
+	extra := map[string]string{
+		"dispatchtypecode": params.DispatchTypeCode,
+		"machinecode":      params.MachineCode,
+		"description":      params.Description,
+		"reported":         params.Reported.Format(MinuteFormat),
+		"completed":        params.Completed.Format(MinuteFormat),
+	}
+
+	var d Dispatch
+	if err := c.post(ctx, "api/1.0/dispatches/add/", c.params(extra), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,27 @@
+package dispatch
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListDispatchTypes returns the dispatch types matching filter, e.g. Filter{"active": "true"}.
+func (c *Client) ListDispatchTypes(ctx context.Context, filter Filter) ([]DispatchType, error) {
+	var types []DispatchType
+	if err := c.get(ctx, "api/1.0/dispatchtypes/", c.params(filter), &types); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// IterateDispatchTypes pages through the dispatch types matching filter,
+// correctly advancing "offset" on every page and stopping once a short page
+// is returned.
+func (c *Client) IterateDispatchTypes(ctx context.Context, filter Filter) *Iterator[DispatchType] {
+	return newIterator(ctx, defaultPageLimit, func(ctx context.Context, limit, offset int) ([]DispatchType, error) {
+		page := cloneFilter(filter)
+		page["limit"] = strconv.Itoa(limit)
+		page["offset"] = strconv.Itoa(offset)
+		return c.ListDispatchTypes(ctx, page)
+	})
+}
@@ -0,0 +1,78 @@
+package dispatch
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/leading2lean/les-go/openapi/gen"
+)
+
+// generatedBaseURL is the server argument NewXxxRequest functions in
+// openapi/gen expect: a full base URL including the API version path.
+func (c *Client) generatedBaseURL() string {
+	return fmt.Sprintf("https://%s/api/1.0/", c.server)
+}
+
+// sign adds the auth query parameter the generated request builders don't
+// know about, since the spec's apiKey security scheme isn't wired into
+// oapi-codegen's client generation.
+func (c *Client) sign(req *http.Request) {
+	q := req.URL.Query()
+	q.Set("auth", c.apikey)
+	req.URL.RawQuery = q.Encode()
+}
+
+// addUnknownFilterKeys forwards any filter entry not in known onto req's
+// query string. The generated Params structs only have fields for the keys
+// in the spec, so this keeps ad-hoc filter keys working the same way they
+// did against the hand-written c.params(filter) request building.
+func addUnknownFilterKeys(req *http.Request, filter Filter, known ...string) {
+	if len(filter) == 0 {
+		return
+	}
+
+	q := req.URL.Query()
+	for k, v := range filter {
+		isKnown := false
+		for _, kk := range known {
+			if k == kk {
+				isKnown = true
+				break
+			}
+		}
+		if !isKnown {
+			q.Set(k, v)
+		}
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+func strPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func strVal(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func intVal(n *int) int {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+// siteFromGen converts a generated gen.Site into the hand-maintained Site type.
+func siteFromGen(s gen.Site) Site {
+	return Site{Id: intVal(s.Id), Code: strVal(s.Code), Description: strVal(s.Description)}
+}
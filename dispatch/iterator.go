@@ -0,0 +1,102 @@
+package dispatch
+
+import "context"
+
+// defaultPageLimit is the page size Iterate* methods request per page when
+// paging through a list endpoint.
+const defaultPageLimit = 100
+
+// Iterator pages through a list endpoint one page at a time, re-issuing the
+// request with an advanced offset each time the current page is exhausted.
+// Use Next to advance, Item to read the current value, and Err to check
+// for a fetch error after Next returns false.
+type Iterator[T any] struct {
+	ctx   context.Context
+	limit int
+	fetch func(ctx context.Context, limit, offset int) ([]T, error)
+
+	page   []T
+	idx    int
+	offset int
+	done   bool
+	err    error
+	cur    T
+}
+
+func newIterator[T any](ctx context.Context, limit int, fetch func(ctx context.Context, limit, offset int) ([]T, error)) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, limit: limit, fetch: fetch}
+}
+
+// Next advances the iterator, fetching the next page from the API once the
+// current page is exhausted. It returns false when there are no more
+// items, or when a fetch fails - call Err to distinguish the two.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.idx < len(it.page) {
+		it.cur = it.page[it.idx]
+		it.idx++
+		return true
+	}
+
+	if it.done {
+		return false
+	}
+
+	page, err := it.fetch(it.ctx, it.limit, it.offset)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.offset += len(page)
+	if len(page) < it.limit {
+		it.done = true
+	}
+
+	if len(page) == 0 {
+		return false
+	}
+
+	it.page = page
+	it.cur = it.page[0]
+	it.idx = 1
+	return true
+}
+
+// Item returns the value most recently produced by Next.
+func (it *Iterator[T]) Item() T {
+	return it.cur
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// ListAll drains it into a slice, stopping early once max items have been
+// collected (max <= 0 means unbounded). It returns whatever it collected
+// even when it returns a non-nil error, so callers can decide whether a
+// partial result is useful.
+func ListAll[T any](it *Iterator[T], max int) ([]T, error) {
+	var out []T
+	for it.Next() {
+		out = append(out, it.Item())
+		if max > 0 && len(out) >= max {
+			break
+		}
+	}
+	return out, it.Err()
+}
+
+// cloneFilter returns a copy of f so Iterate* methods can add limit/offset
+// without mutating the caller's Filter.
+func cloneFilter(f Filter) Filter {
+	out := make(Filter, len(f)+2)
+	for k, v := range f {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakePages returns a fetch func that serves pages sliced out of items,
+// exactly as a real ListXxx call would for the given limit/offset.
+func fakePages(items []int) func(ctx context.Context, limit, offset int) ([]int, error) {
+	return func(ctx context.Context, limit, offset int) ([]int, error) {
+		if offset >= len(items) {
+			return nil, nil
+		}
+		end := offset + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[offset:end], nil
+	}
+}
+
+func TestIteratorKeepsPagingWhenAPageIsExactlyLimit(t *testing.T) {
+	// Two full pages of 3 followed by a short page of 1: the bug this
+	// request fixed was stopping after the first full page because
+	// len(page) <= limit was true for a full page too.
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	it := newIterator(context.Background(), 3, fakePages(items))
+
+	got, err := ListAll(it, 0)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v, want nil", err)
+	}
+	if len(got) != len(items) {
+		t.Fatalf("ListAll() collected %d items, want %d: %v", len(got), len(items), got)
+	}
+	for i, v := range got {
+		if v != items[i] {
+			t.Errorf("got[%d] = %d, want %d", i, v, items[i])
+		}
+	}
+}
+
+func TestIteratorStopsExactlyOnAFullFinalPage(t *testing.T) {
+	// A result set that's an exact multiple of limit: the iterator must
+	// still terminate (the page after the last one is empty) rather than
+	// looping forever or erroring.
+	items := []int{1, 2, 3, 4}
+	it := newIterator(context.Background(), 2, fakePages(items))
+
+	got, err := ListAll(it, 0)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v, want nil", err)
+	}
+	if len(got) != len(items) {
+		t.Fatalf("ListAll() collected %d items, want %d: %v", len(got), len(items), got)
+	}
+}
+
+func TestListAllStopsAtMax(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+	it := newIterator(context.Background(), 3, fakePages(items))
+
+	got, err := ListAll(it, 4)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v, want nil", err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("ListAll() collected %d items, want 4: %v", len(got), got)
+	}
+}
+
+func TestIteratorStopsOnFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	it := newIterator(context.Background(), 2, func(ctx context.Context, limit, offset int) ([]int, error) {
+		calls++
+		if calls == 2 {
+			return nil, wantErr
+		}
+		return []int{1, 2}, nil
+	})
+
+	got, err := ListAll(it, 0)
+	if err != wantErr {
+		t.Fatalf("ListAll() error = %v, want %v", err, wantErr)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListAll() collected %d items before the error, want 2: %v", len(got), got)
+	}
+}
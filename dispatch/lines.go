@@ -0,0 +1,26 @@
+package dispatch
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListLines returns the lines matching filter, e.g. Filter{"area_id": "12", "active": "true"}.
+func (c *Client) ListLines(ctx context.Context, filter Filter) ([]Line, error) {
+	var lines []Line
+	if err := c.get(ctx, "api/1.0/lines/", c.params(filter), &lines); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// IterateLines pages through the lines matching filter, correctly advancing
+// "offset" on every page and stopping once a short page is returned.
+func (c *Client) IterateLines(ctx context.Context, filter Filter) *Iterator[Line] {
+	return newIterator(ctx, defaultPageLimit, func(ctx context.Context, limit, offset int) ([]Line, error) {
+		page := cloneFilter(filter)
+		page["limit"] = strconv.Itoa(limit)
+		page["offset"] = strconv.Itoa(offset)
+		return c.ListLines(ctx, page)
+	})
+}
@@ -0,0 +1,61 @@
+package dispatch
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListMachines returns the machines matching filter, e.g. Filter{"line_id": "34", "active": "true"}.
+func (c *Client) ListMachines(ctx context.Context, filter Filter) ([]Machine, error) {
+	var machines []Machine
+	if err := c.get(ctx, "api/1.0/machines/", c.params(filter), &machines); err != nil {
+		return nil, err
+	}
+	return machines, nil
+}
+
+// IterateMachines pages through the machines matching filter, correctly
+// advancing "offset" on every page and stopping once a short page is returned.
+func (c *Client) IterateMachines(ctx context.Context, filter Filter) *Iterator[Machine] {
+	return newIterator(ctx, defaultPageLimit, func(ctx context.Context, limit, offset int) ([]Machine, error) {
+		page := cloneFilter(filter)
+		page["limit"] = strconv.Itoa(limit)
+		page["offset"] = strconv.Itoa(offset)
+		return c.ListMachines(ctx, page)
+	})
+}
+
+// SetCycleCount sets a machine's cycle count to an absolute value. Since
+// setting an absolute value is idempotent, this call is retried on
+// transient failures.
+func (c *Client) SetCycleCount(ctx context.Context, machineCode string, cycleCount int) error {
+	form := c.params(map[string]string{
+		"code":       machineCode,
+		"cyclecount": strconv.Itoa(cycleCount),
+	})
+	return c.post(ctx, "api/1.0/machines/set_cycle_count/", form, nil, AllowRetry())
+}
+
+// IncrementCycleCountParams configures a call to IncrementCycleCount.
+type IncrementCycleCountParams struct {
+	// By is the amount to add to the machine's current cycle count.
+	By int
+	// SkipLastUpdated skips updating the machine's lastupdated timestamp.
+	// Set this for high frequency machines that call this endpoint often
+	// enough that per-call tracking isn't useful.
+	SkipLastUpdated bool
+}
+
+// IncrementCycleCount adds params.By to a machine's current cycle count.
+func (c *Client) IncrementCycleCount(ctx context.Context, machineCode string, params IncrementCycleCountParams) error {
+	extra := map[string]string{
+		"code":       machineCode,
+		"cyclecount": strconv.Itoa(params.By),
+	}
+	if params.SkipLastUpdated {
+		extra["skip_lastupdated"] = "1"
+	}
+
+	form := c.params(extra)
+	return c.post(ctx, "api/1.0/machines/increment_cycle_count/", form, nil)
+}
@@ -0,0 +1,70 @@
+package dispatch
+
+import (
+	"context"
+	"strconv"
+)
+
+// RecordPitchDetailsParams configures a call to RecordPitchDetails.
+type RecordPitchDetailsParams struct {
+	LineCode      string
+	ProductCode   string
+	Actual        int
+	Scrap         int
+	OperatorCount int
+	// Start and End bound the pitch being recorded, in the site's local
+	// time using MinuteFormat, or the literal string "now".
+	Start, End string
+}
+
+// PitchDetail is a single recorded pitch.
+type PitchDetail struct {
+	Id int `json:"id"`
+}
+
+// RecordPitchDetails records production data (actual/scrap counts) for a pitch on a line.
+func (c *Client) RecordPitchDetails(ctx context.Context, params RecordPitchDetailsParams) (*PitchDetail, error) {
+	extra := map[string]string{
+		"linecode":       params.LineCode,
+		"productcode":    params.ProductCode,
+		"actual":         strconv.Itoa(params.Actual),
+		"scrap":          strconv.Itoa(params.Scrap),
+		"operator_count": strconv.Itoa(params.OperatorCount),
+		"start":          params.Start,
+		"end":            params.End,
+	}
+
+	var pd PitchDetail
+	if err := c.post(ctx, "api/1.0/pitchdetails/record_details/", c.params(extra), &pd); err != nil {
+		return nil, err
+	}
+	return &pd, nil
+}
+
+// PitchDetailsProduct summarizes actual/scrap counts for a single product
+// within a GetPitchDetails report.
+type PitchDetailsProduct struct {
+	ProductCode string `json:"productcode"`
+	Actual      int    `json:"actual"`
+	Scrap       int    `json:"scrap"`
+}
+
+// PitchDetailsSummary is the production reporting data for a line over a
+// date range, as returned by GetPitchDetails.
+type PitchDetailsSummary struct {
+	LineCode string                `json:"linecode"`
+	Products []PitchDetailsProduct `json:"products"`
+}
+
+// GetPitchDetails retrieves the production reporting data for a line over
+// a date range. filter supports "start", "end", "linecode", "productcode"
+// and "show_products". Unlike the entity list endpoints, this returns one
+// aggregated summary rather than a page of records, so there is no
+// IteratePitchDetails - there's nothing to page through.
+func (c *Client) GetPitchDetails(ctx context.Context, filter Filter) (*PitchDetailsSummary, error) {
+	var summary PitchDetailsSummary
+	if err := c.get(ctx, "api/1.0/pitchdetails/record_details/", c.params(filter), &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
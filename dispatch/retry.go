@@ -0,0 +1,111 @@
+package dispatch
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry tuning, used when NewClient is called without the
+// corresponding Option.
+const (
+	DefaultMaxAttempts   = 3
+	DefaultRetryInterval = 250 * time.Millisecond
+	DefaultRetryTimeout  = 30 * time.Second
+)
+
+// WithMaxAttempts sets the maximum number of times a retryable request is
+// attempted, including the initial try. The default is DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(c *Client) {
+		c.maxAttempts = n
+	}
+}
+
+// WithRetryInterval sets the base interval for exponential backoff between
+// retries. The default is DefaultRetryInterval.
+func WithRetryInterval(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryInterval = d
+	}
+}
+
+// WithRetryTimeout sets a hard deadline, measured from the first attempt,
+// after which no further retries are made even if attempts remain. It also
+// bounds each individual attempt, including the first, so a connection
+// that never responds can't block past the deadline. The default is
+// DefaultRetryTimeout.
+func WithRetryTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryTimeout = d
+	}
+}
+
+// WithOnRetry sets a hook that is called before each retry, so callers can
+// log or record metrics. next is how long the client will sleep before the
+// retry fires.
+func WithOnRetry(fn func(attempt int, err error, next time.Duration)) Option {
+	return func(c *Client) {
+		c.onRetry = fn
+	}
+}
+
+// requestConfig holds per-call overrides applied via requestOption.
+type requestConfig struct {
+	retryable bool
+}
+
+// requestOption customizes a single Client.post call, on top of the
+// Client's retry configuration.
+type requestOption func(*requestConfig)
+
+// AllowRetry marks a POST call as safe to retry on transient failures, i.e.
+// idempotent. GET requests are always retryable; POST requests are not,
+// unless the caller opts in with AllowRetry for a specific call.
+func AllowRetry() requestOption {
+	return func(rc *requestConfig) {
+		rc.retryable = true
+	}
+}
+
+// backoff returns how long to wait before the given retry attempt (1-based),
+// as exponential backoff off of base with full jitter. A non-positive base
+// (e.g. WithRetryInterval(0)) means "retry immediately, no backoff".
+func backoff(base time.Duration, attempt int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	max := base * time.Duration(1<<uint(attempt-1))
+	if max <= 0 {
+		max = base
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// retryAfter parses a Retry-After header, returning the duration to wait
+// and whether the header was present and valid.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(v); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// isRetryableStatus reports whether a response's status code is worth
+// retrying: a 5xx server error, or 429 Too Many Requests.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
@@ -0,0 +1,29 @@
+package dispatch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffZeroBaseDoesNotPanic(t *testing.T) {
+	// WithRetryInterval(0) is a legitimate way to ask for "retry
+	// immediately, no backoff" - it must not reach rand.Int63n(0).
+	for attempt := 1; attempt <= 5; attempt++ {
+		if got := backoff(0, attempt); got != 0 {
+			t.Errorf("backoff(0, %d) = %v, want 0", attempt, got)
+		}
+	}
+}
+
+func TestBackoffWithinBounds(t *testing.T) {
+	base := 10 * time.Millisecond
+	for attempt := 1; attempt <= 4; attempt++ {
+		max := base * time.Duration(int64(1)<<uint(attempt-1))
+		for i := 0; i < 20; i++ {
+			got := backoff(base, attempt)
+			if got < 0 || got >= max {
+				t.Fatalf("backoff(%v, %d) = %v, want in [0, %v)", base, attempt, got, max)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/leading2lean/les-go/openapi/gen"
+)
+
+// ListSites returns the sites matching filter, e.g. Filter{"active": "true"}.
+//
+// This is the first endpoint migrated onto the generated openapi/gen
+// request models (see openapi/generate.go); request building comes from
+// gen.NewListSitesRequest, while retries, auth and response decoding stay
+// on Client so every endpoint keeps behaving the same way regardless of
+// whether it's been migrated yet.
+func (c *Client) ListSites(ctx context.Context, filter Filter) ([]Site, error) {
+	params := &gen.ListSitesParams{Site: c.site, Active: strPtr(filter["active"]), TestSite: strPtr(filter["test_site"])}
+	if v, err := strconv.Atoi(filter["limit"]); err == nil {
+		params.Limit = intPtr(v)
+	}
+	if v, err := strconv.Atoi(filter["offset"]); err == nil {
+		params.Offset = intPtr(v)
+	}
+
+	req, err := gen.NewListSitesRequest(c.generatedBaseURL(), params)
+	if err != nil {
+		return nil, fmt.Errorf("dispatch: building request: %w", err)
+	}
+	req = req.WithContext(ctx)
+	addUnknownFilterKeys(req, filter, "active", "test_site", "limit", "offset")
+	c.sign(req)
+
+	var raw []gen.Site
+	if err := c.do(ctx, req, &raw, true); err != nil {
+		return nil, err
+	}
+
+	sites := make([]Site, len(raw))
+	for i, s := range raw {
+		sites[i] = siteFromGen(s)
+	}
+	return sites, nil
+}
+
+// IterateSites pages through the sites matching filter, correctly advancing
+// "offset" on every page and stopping once a short page is returned.
+func (c *Client) IterateSites(ctx context.Context, filter Filter) *Iterator[Site] {
+	return newIterator(ctx, defaultPageLimit, func(ctx context.Context, limit, offset int) ([]Site, error) {
+		page := cloneFilter(filter)
+		page["limit"] = strconv.Itoa(limit)
+		page["offset"] = strconv.Itoa(offset)
+		return c.ListSites(ctx, page)
+	})
+}
@@ -0,0 +1,62 @@
+package dispatch
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestListSitesBuildsRequestThroughGeneratedLayer(t *testing.T) {
+	var gotQuery url.Values
+	client := testClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Write([]byte(`{"success":true,"data":[{"id":1,"code":"SITE1","description":"Main Plant"}]}`))
+	}))
+
+	sites, err := client.ListSites(context.Background(), Filter{
+		"active":    "true",
+		"test_site": "false",
+		"limit":     "50",
+		"offset":    "not-a-number", // non-numeric: silently left off the request, not an error
+		"code":      "PLANT1",       // not in the generated Params struct, must still be forwarded
+	})
+	if err != nil {
+		t.Fatalf("ListSites() error = %v, want nil", err)
+	}
+
+	if len(sites) != 1 || sites[0].Code != "SITE1" || sites[0].Description != "Main Plant" {
+		t.Fatalf("ListSites() = %+v, want one decoded Site", sites)
+	}
+
+	// sign: auth is injected from the Client, not the caller.
+	if got := gotQuery.Get("auth"); got != "testkey" {
+		t.Errorf("auth = %q, want testkey", got)
+	}
+	if got := gotQuery.Get("site"); got != "SITE1" {
+		t.Errorf("site = %q, want SITE1", got)
+	}
+
+	// Known params mapped onto gen.ListSitesParams.
+	if got := gotQuery.Get("active"); got != "true" {
+		t.Errorf("active = %q, want true", got)
+	}
+	if got := gotQuery.Get("test_site"); got != "false" {
+		t.Errorf("test_site = %q, want false", got)
+	}
+	if got := gotQuery.Get("limit"); got != "50" {
+		t.Errorf("limit = %q, want 50", got)
+	}
+
+	// A non-numeric limit/offset is dropped rather than erroring.
+	if gotQuery.Has("offset") {
+		t.Errorf("offset = %q, want it absent since the filter value wasn't numeric", gotQuery.Get("offset"))
+	}
+
+	// addUnknownFilterKeys: a key with no field on gen.ListSitesParams
+	// still reaches the request, matching every other List* method's
+	// c.params(filter) passthrough behavior.
+	if got := gotQuery.Get("code"); got != "PLANT1" {
+		t.Errorf("code = %q, want PLANT1 (unknown filter keys must still be forwarded)", got)
+	}
+}
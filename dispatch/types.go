@@ -0,0 +1,45 @@
+package dispatch
+
+// Filter holds query parameters for a list endpoint, e.g. Filter{"active": "true"}.
+type Filter map[string]string
+
+// Site is a Dispatch site/plant.
+type Site struct {
+	Id          int    `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Area is a production area within a site.
+type Area struct {
+	Id          int    `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Line is a production line within an Area.
+type Line struct {
+	Id          int    `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Machine is a machine on a Line.
+type Machine struct {
+	Id          int    `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// DispatchType is a category of Dispatch, e.g. "maintenance" or "quality".
+type DispatchType struct {
+	Id          int    `json:"id"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// Dispatch is an event recorded against a Machine, such as a maintenance
+// call, that is opened, worked, and closed.
+type Dispatch struct {
+	Id int `json:"id"`
+}
@@ -0,0 +1,43 @@
+package dispatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClockInParams configures a call to ClockIn.
+type ClockInParams struct {
+	LineCode string
+	// Start and End record a clock-in session that already happened, in
+	// the site's local time. Leave both zero to clock in as of now.
+	Start, End time.Time
+}
+
+// ClockIn records user clocking in to work on a line. Supplying Start and
+// End backdates the clock-in instead of starting an open session now.
+func (c *Client) ClockIn(ctx context.Context, user string, params ClockInParams) error {
+	extra := map[string]string{"linecode": params.LineCode}
+	if !params.Start.IsZero() {
+		extra["start"] = params.Start.Format(MinuteFormat)
+	}
+	if !params.End.IsZero() {
+		extra["end"] = params.End.Format(MinuteFormat)
+	}
+
+	path := fmt.Sprintf("api/1.0/users/clock_in/%s/", user)
+	return c.post(ctx, path, c.params(extra), nil)
+}
+
+// ClockOutParams configures a call to ClockOut.
+type ClockOutParams struct {
+	LineCode string
+}
+
+// ClockOut records a user clocking out of their current open clock-in session.
+func (c *Client) ClockOut(ctx context.Context, user string, params ClockOutParams) error {
+	extra := map[string]string{"linecode": params.LineCode}
+
+	path := fmt.Sprintf("api/1.0/users/clock_out/%s/", user)
+	return c.post(ctx, path, c.params(extra), nil)
+}
@@ -0,0 +1,2570 @@
+// Package gen provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApikeyScopes = "apikey.Scopes"
+)
+
+// Area defines model for Area.
+type Area struct {
+	Code        *string `json:"code,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+}
+
+// Dispatch defines model for Dispatch.
+type Dispatch struct {
+	Id *int `json:"id,omitempty"`
+}
+
+// DispatchType defines model for DispatchType.
+type DispatchType struct {
+	Code        *string `json:"code,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+}
+
+// Line defines model for Line.
+type Line struct {
+	Code        *string `json:"code,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+}
+
+// Machine defines model for Machine.
+type Machine struct {
+	Code        *string `json:"code,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+}
+
+// PitchDetail defines model for PitchDetail.
+type PitchDetail struct {
+	Id *int `json:"id,omitempty"`
+}
+
+// PitchDetailsProduct defines model for PitchDetailsProduct.
+type PitchDetailsProduct struct {
+	Actual      *int    `json:"actual,omitempty"`
+	Productcode *string `json:"productcode,omitempty"`
+	Scrap       *int    `json:"scrap,omitempty"`
+}
+
+// PitchDetailsSummary defines model for PitchDetailsSummary.
+type PitchDetailsSummary struct {
+	Linecode *string                `json:"linecode,omitempty"`
+	Products *[]PitchDetailsProduct `json:"products,omitempty"`
+}
+
+// Site defines model for Site.
+type Site struct {
+	Code        *string `json:"code,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+}
+
+// AreaList defines model for AreaList.
+type AreaList struct {
+	Data    *[]Area `json:"data,omitempty"`
+	Error   *string `json:"error,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// DispatchResponse defines model for DispatchResponse.
+type DispatchResponse struct {
+	Data    *Dispatch `json:"data,omitempty"`
+	Error   *string   `json:"error,omitempty"`
+	Success *bool     `json:"success,omitempty"`
+}
+
+// DispatchTypeList defines model for DispatchTypeList.
+type DispatchTypeList struct {
+	Data    *[]DispatchType `json:"data,omitempty"`
+	Error   *string         `json:"error,omitempty"`
+	Success *bool           `json:"success,omitempty"`
+}
+
+// Empty defines model for Empty.
+type Empty struct {
+	Error   *string `json:"error,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// LineList defines model for LineList.
+type LineList struct {
+	Data    *[]Line `json:"data,omitempty"`
+	Error   *string `json:"error,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// MachineList defines model for MachineList.
+type MachineList struct {
+	Data    *[]Machine `json:"data,omitempty"`
+	Error   *string    `json:"error,omitempty"`
+	Success *bool      `json:"success,omitempty"`
+}
+
+// PitchDetailResponse defines model for PitchDetailResponse.
+type PitchDetailResponse struct {
+	Data    *PitchDetail `json:"data,omitempty"`
+	Error   *string      `json:"error,omitempty"`
+	Success *bool        `json:"success,omitempty"`
+}
+
+// PitchDetailsSummaryResponse defines model for PitchDetailsSummaryResponse.
+type PitchDetailsSummaryResponse struct {
+	Data    *PitchDetailsSummary `json:"data,omitempty"`
+	Error   *string              `json:"error,omitempty"`
+	Success *bool                `json:"success,omitempty"`
+}
+
+// SiteList defines model for SiteList.
+type SiteList struct {
+	Data    *[]Site `json:"data,omitempty"`
+	Error   *string `json:"error,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// ListAreasParams defines parameters for ListAreas.
+type ListAreasParams struct {
+	Site   string  `form:"site" json:"site"`
+	Active *string `form:"active,omitempty" json:"active,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AddDispatchFormdataBody defines parameters for AddDispatch.
+type AddDispatchFormdataBody struct {
+	Completed        string `form:"completed" json:"completed"`
+	Description      string `form:"description" json:"description"`
+	Dispatchtypecode string `form:"dispatchtypecode" json:"dispatchtypecode"`
+	Machinecode      string `form:"machinecode" json:"machinecode"`
+	Reported         string `form:"reported" json:"reported"`
+}
+
+// OpenDispatchFormdataBody defines parameters for OpenDispatch.
+type OpenDispatchFormdataBody struct {
+	Description  string  `form:"description" json:"description"`
+	Dispatchtype int     `form:"dispatchtype" json:"dispatchtype"`
+	End          *string `form:"end,omitempty" json:"end,omitempty"`
+	Machine      int     `form:"machine" json:"machine"`
+	Start        *string `form:"start,omitempty" json:"start,omitempty"`
+}
+
+// ListDispatchTypesParams defines parameters for ListDispatchTypes.
+type ListDispatchTypesParams struct {
+	Site   string  `form:"site" json:"site"`
+	Active *string `form:"active,omitempty" json:"active,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListLinesParams defines parameters for ListLines.
+type ListLinesParams struct {
+	Site   string  `form:"site" json:"site"`
+	AreaId *string `form:"area_id,omitempty" json:"area_id,omitempty"`
+	Active *string `form:"active,omitempty" json:"active,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMachinesParams defines parameters for ListMachines.
+type ListMachinesParams struct {
+	Site   string  `form:"site" json:"site"`
+	LineId *string `form:"line_id,omitempty" json:"line_id,omitempty"`
+	Active *string `form:"active,omitempty" json:"active,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// IncrementMachineCycleCountFormdataBody defines parameters for IncrementMachineCycleCount.
+type IncrementMachineCycleCountFormdataBody struct {
+	Code            string  `form:"code" json:"code"`
+	Cyclecount      int     `form:"cyclecount" json:"cyclecount"`
+	SkipLastupdated *string `form:"skip_lastupdated,omitempty" json:"skip_lastupdated,omitempty"`
+}
+
+// SetMachineCycleCountFormdataBody defines parameters for SetMachineCycleCount.
+type SetMachineCycleCountFormdataBody struct {
+	Code       string `form:"code" json:"code"`
+	Cyclecount int    `form:"cyclecount" json:"cyclecount"`
+}
+
+// GetPitchDetailsParams defines parameters for GetPitchDetails.
+type GetPitchDetailsParams struct {
+	Site         string  `form:"site" json:"site"`
+	Start        *string `form:"start,omitempty" json:"start,omitempty"`
+	End          *string `form:"end,omitempty" json:"end,omitempty"`
+	Linecode     *string `form:"linecode,omitempty" json:"linecode,omitempty"`
+	Productcode  *string `form:"productcode,omitempty" json:"productcode,omitempty"`
+	ShowProducts *string `form:"show_products,omitempty" json:"show_products,omitempty"`
+}
+
+// RecordPitchDetailsFormdataBody defines parameters for RecordPitchDetails.
+type RecordPitchDetailsFormdataBody struct {
+	Actual        int     `form:"actual" json:"actual"`
+	End           *string `form:"end,omitempty" json:"end,omitempty"`
+	Linecode      string  `form:"linecode" json:"linecode"`
+	OperatorCount *int    `form:"operator_count,omitempty" json:"operator_count,omitempty"`
+	Productcode   string  `form:"productcode" json:"productcode"`
+	Scrap         int     `form:"scrap" json:"scrap"`
+	Start         *string `form:"start,omitempty" json:"start,omitempty"`
+}
+
+// ListSitesParams defines parameters for ListSites.
+type ListSitesParams struct {
+	Site     string  `form:"site" json:"site"`
+	Active   *string `form:"active,omitempty" json:"active,omitempty"`
+	TestSite *string `form:"test_site,omitempty" json:"test_site,omitempty"`
+	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ClockInFormdataBody defines parameters for ClockIn.
+type ClockInFormdataBody struct {
+	End      *string `form:"end,omitempty" json:"end,omitempty"`
+	Linecode string  `form:"linecode" json:"linecode"`
+	Start    *string `form:"start,omitempty" json:"start,omitempty"`
+}
+
+// ClockOutFormdataBody defines parameters for ClockOut.
+type ClockOutFormdataBody struct {
+	Linecode string `form:"linecode" json:"linecode"`
+}
+
+// AddDispatchFormdataRequestBody defines body for AddDispatch for application/x-www-form-urlencoded ContentType.
+type AddDispatchFormdataRequestBody AddDispatchFormdataBody
+
+// OpenDispatchFormdataRequestBody defines body for OpenDispatch for application/x-www-form-urlencoded ContentType.
+type OpenDispatchFormdataRequestBody OpenDispatchFormdataBody
+
+// IncrementMachineCycleCountFormdataRequestBody defines body for IncrementMachineCycleCount for application/x-www-form-urlencoded ContentType.
+type IncrementMachineCycleCountFormdataRequestBody IncrementMachineCycleCountFormdataBody
+
+// SetMachineCycleCountFormdataRequestBody defines body for SetMachineCycleCount for application/x-www-form-urlencoded ContentType.
+type SetMachineCycleCountFormdataRequestBody SetMachineCycleCountFormdataBody
+
+// RecordPitchDetailsFormdataRequestBody defines body for RecordPitchDetails for application/x-www-form-urlencoded ContentType.
+type RecordPitchDetailsFormdataRequestBody RecordPitchDetailsFormdataBody
+
+// ClockInFormdataRequestBody defines body for ClockIn for application/x-www-form-urlencoded ContentType.
+type ClockInFormdataRequestBody ClockInFormdataBody
+
+// ClockOutFormdataRequestBody defines body for ClockOut for application/x-www-form-urlencoded ContentType.
+type ClockOutFormdataRequestBody ClockOutFormdataBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAreas request
+	ListAreas(ctx context.Context, params *ListAreasParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddDispatchWithBody request with any body
+	AddDispatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddDispatchWithFormdataBody(ctx context.Context, body AddDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseDispatch request
+	CloseDispatch(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OpenDispatchWithBody request with any body
+	OpenDispatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	OpenDispatchWithFormdataBody(ctx context.Context, body OpenDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDispatchTypes request
+	ListDispatchTypes(ctx context.Context, params *ListDispatchTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLines request
+	ListLines(ctx context.Context, params *ListLinesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMachines request
+	ListMachines(ctx context.Context, params *ListMachinesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IncrementMachineCycleCountWithBody request with any body
+	IncrementMachineCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	IncrementMachineCycleCountWithFormdataBody(ctx context.Context, body IncrementMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetMachineCycleCountWithBody request with any body
+	SetMachineCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetMachineCycleCountWithFormdataBody(ctx context.Context, body SetMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPitchDetails request
+	GetPitchDetails(ctx context.Context, params *GetPitchDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordPitchDetailsWithBody request with any body
+	RecordPitchDetailsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordPitchDetailsWithFormdataBody(ctx context.Context, body RecordPitchDetailsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSites request
+	ListSites(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClockInWithBody request with any body
+	ClockInWithBody(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ClockInWithFormdataBody(ctx context.Context, user string, body ClockInFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClockOutWithBody request with any body
+	ClockOutWithBody(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ClockOutWithFormdataBody(ctx context.Context, user string, body ClockOutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAreas(ctx context.Context, params *ListAreasParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAreasRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddDispatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDispatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddDispatchWithFormdataBody(ctx context.Context, body AddDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDispatchRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseDispatch(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseDispatchRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OpenDispatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOpenDispatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) OpenDispatchWithFormdataBody(ctx context.Context, body OpenDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOpenDispatchRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDispatchTypes(ctx context.Context, params *ListDispatchTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDispatchTypesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLines(ctx context.Context, params *ListLinesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLinesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMachines(ctx context.Context, params *ListMachinesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMachinesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IncrementMachineCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIncrementMachineCycleCountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IncrementMachineCycleCountWithFormdataBody(ctx context.Context, body IncrementMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIncrementMachineCycleCountRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetMachineCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMachineCycleCountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetMachineCycleCountWithFormdataBody(ctx context.Context, body SetMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMachineCycleCountRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPitchDetails(ctx context.Context, params *GetPitchDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPitchDetailsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordPitchDetailsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordPitchDetailsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordPitchDetailsWithFormdataBody(ctx context.Context, body RecordPitchDetailsFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordPitchDetailsRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSites(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSitesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClockInWithBody(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClockInRequestWithBody(c.Server, user, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClockInWithFormdataBody(ctx context.Context, user string, body ClockInFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClockInRequestWithFormdataBody(c.Server, user, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClockOutWithBody(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClockOutRequestWithBody(c.Server, user, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClockOutWithFormdataBody(ctx context.Context, user string, body ClockOutFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClockOutRequestWithFormdataBody(c.Server, user, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAreasRequest generates requests for ListAreas
+func NewListAreasRequest(server string, params *ListAreasParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/areas/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddDispatchRequestWithFormdataBody calls the generic AddDispatch builder with application/x-www-form-urlencoded body
+func NewAddDispatchRequestWithFormdataBody(server string, body AddDispatchFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewAddDispatchRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewAddDispatchRequestWithBody generates requests for AddDispatch with any type of body
+func NewAddDispatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dispatches/add/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCloseDispatchRequest generates requests for CloseDispatch
+func NewCloseDispatchRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dispatches/close/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOpenDispatchRequestWithFormdataBody calls the generic OpenDispatch builder with application/x-www-form-urlencoded body
+func NewOpenDispatchRequestWithFormdataBody(server string, body OpenDispatchFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewOpenDispatchRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewOpenDispatchRequestWithBody generates requests for OpenDispatch with any type of body
+func NewOpenDispatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dispatches/open/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDispatchTypesRequest generates requests for ListDispatchTypes
+func NewListDispatchTypesRequest(server string, params *ListDispatchTypesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dispatchtypes/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLinesRequest generates requests for ListLines
+func NewListLinesRequest(server string, params *ListLinesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lines/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.AreaId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "area_id", runtime.ParamLocationQuery, *params.AreaId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMachinesRequest generates requests for ListMachines
+func NewListMachinesRequest(server string, params *ListMachinesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/machines/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.LineId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "line_id", runtime.ParamLocationQuery, *params.LineId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIncrementMachineCycleCountRequestWithFormdataBody calls the generic IncrementMachineCycleCount builder with application/x-www-form-urlencoded body
+func NewIncrementMachineCycleCountRequestWithFormdataBody(server string, body IncrementMachineCycleCountFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewIncrementMachineCycleCountRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewIncrementMachineCycleCountRequestWithBody generates requests for IncrementMachineCycleCount with any type of body
+func NewIncrementMachineCycleCountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/machines/increment_cycle_count/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetMachineCycleCountRequestWithFormdataBody calls the generic SetMachineCycleCount builder with application/x-www-form-urlencoded body
+func NewSetMachineCycleCountRequestWithFormdataBody(server string, body SetMachineCycleCountFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSetMachineCycleCountRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSetMachineCycleCountRequestWithBody generates requests for SetMachineCycleCount with any type of body
+func NewSetMachineCycleCountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/machines/set_cycle_count/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPitchDetailsRequest generates requests for GetPitchDetails
+func NewGetPitchDetailsRequest(server string, params *GetPitchDetailsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pitchdetails/record_details/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Linecode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "linecode", runtime.ParamLocationQuery, *params.Linecode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Productcode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "productcode", runtime.ParamLocationQuery, *params.Productcode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowProducts != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "show_products", runtime.ParamLocationQuery, *params.ShowProducts); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordPitchDetailsRequestWithFormdataBody calls the generic RecordPitchDetails builder with application/x-www-form-urlencoded body
+func NewRecordPitchDetailsRequestWithFormdataBody(server string, body RecordPitchDetailsFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRecordPitchDetailsRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRecordPitchDetailsRequestWithBody generates requests for RecordPitchDetails with any type of body
+func NewRecordPitchDetailsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pitchdetails/record_details/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSitesRequest generates requests for ListSites
+func NewListSitesRequest(server string, params *ListSitesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sites/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, params.Site); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TestSite != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "test_site", runtime.ParamLocationQuery, *params.TestSite); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewClockInRequestWithFormdataBody calls the generic ClockIn builder with application/x-www-form-urlencoded body
+func NewClockInRequestWithFormdataBody(server string, user string, body ClockInFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewClockInRequestWithBody(server, user, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewClockInRequestWithBody generates requests for ClockIn with any type of body
+func NewClockInRequestWithBody(server string, user string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/clock_in/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewClockOutRequestWithFormdataBody calls the generic ClockOut builder with application/x-www-form-urlencoded body
+func NewClockOutRequestWithFormdataBody(server string, user string, body ClockOutFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewClockOutRequestWithBody(server, user, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewClockOutRequestWithBody generates requests for ClockOut with any type of body
+func NewClockOutRequestWithBody(server string, user string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/clock_out/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAreasWithResponse request
+	ListAreasWithResponse(ctx context.Context, params *ListAreasParams, reqEditors ...RequestEditorFn) (*ListAreasResponse, error)
+
+	// AddDispatchWithBodyWithResponse request with any body
+	AddDispatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDispatchResponse, error)
+
+	AddDispatchWithFormdataBodyWithResponse(ctx context.Context, body AddDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*AddDispatchResponse, error)
+
+	// CloseDispatchWithResponse request
+	CloseDispatchWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*CloseDispatchResponse, error)
+
+	// OpenDispatchWithBodyWithResponse request with any body
+	OpenDispatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OpenDispatchResponse, error)
+
+	OpenDispatchWithFormdataBodyWithResponse(ctx context.Context, body OpenDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*OpenDispatchResponse, error)
+
+	// ListDispatchTypesWithResponse request
+	ListDispatchTypesWithResponse(ctx context.Context, params *ListDispatchTypesParams, reqEditors ...RequestEditorFn) (*ListDispatchTypesResponse, error)
+
+	// ListLinesWithResponse request
+	ListLinesWithResponse(ctx context.Context, params *ListLinesParams, reqEditors ...RequestEditorFn) (*ListLinesResponse, error)
+
+	// ListMachinesWithResponse request
+	ListMachinesWithResponse(ctx context.Context, params *ListMachinesParams, reqEditors ...RequestEditorFn) (*ListMachinesResponse, error)
+
+	// IncrementMachineCycleCountWithBodyWithResponse request with any body
+	IncrementMachineCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IncrementMachineCycleCountResponse, error)
+
+	IncrementMachineCycleCountWithFormdataBodyWithResponse(ctx context.Context, body IncrementMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*IncrementMachineCycleCountResponse, error)
+
+	// SetMachineCycleCountWithBodyWithResponse request with any body
+	SetMachineCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMachineCycleCountResponse, error)
+
+	SetMachineCycleCountWithFormdataBodyWithResponse(ctx context.Context, body SetMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*SetMachineCycleCountResponse, error)
+
+	// GetPitchDetailsWithResponse request
+	GetPitchDetailsWithResponse(ctx context.Context, params *GetPitchDetailsParams, reqEditors ...RequestEditorFn) (*GetPitchDetailsResponse, error)
+
+	// RecordPitchDetailsWithBodyWithResponse request with any body
+	RecordPitchDetailsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordPitchDetailsResponse, error)
+
+	RecordPitchDetailsWithFormdataBodyWithResponse(ctx context.Context, body RecordPitchDetailsFormdataRequestBody, reqEditors ...RequestEditorFn) (*RecordPitchDetailsResponse, error)
+
+	// ListSitesWithResponse request
+	ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error)
+
+	// ClockInWithBodyWithResponse request with any body
+	ClockInWithBodyWithResponse(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClockInResponse, error)
+
+	ClockInWithFormdataBodyWithResponse(ctx context.Context, user string, body ClockInFormdataRequestBody, reqEditors ...RequestEditorFn) (*ClockInResponse, error)
+
+	// ClockOutWithBodyWithResponse request with any body
+	ClockOutWithBodyWithResponse(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClockOutResponse, error)
+
+	ClockOutWithFormdataBodyWithResponse(ctx context.Context, user string, body ClockOutFormdataRequestBody, reqEditors ...RequestEditorFn) (*ClockOutResponse, error)
+}
+
+type ListAreasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AreaList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAreasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAreasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDispatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DispatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDispatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDispatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseDispatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DispatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseDispatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseDispatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OpenDispatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DispatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r OpenDispatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OpenDispatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDispatchTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DispatchTypeList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDispatchTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDispatchTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLinesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LineList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLinesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLinesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMachinesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MachineList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMachinesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMachinesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IncrementMachineCycleCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Empty
+}
+
+// Status returns HTTPResponse.Status
+func (r IncrementMachineCycleCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IncrementMachineCycleCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetMachineCycleCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Empty
+}
+
+// Status returns HTTPResponse.Status
+func (r SetMachineCycleCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetMachineCycleCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPitchDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PitchDetailsSummaryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPitchDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPitchDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordPitchDetailsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PitchDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordPitchDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordPitchDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SiteList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClockInResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Empty
+}
+
+// Status returns HTTPResponse.Status
+func (r ClockInResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClockInResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClockOutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Empty
+}
+
+// Status returns HTTPResponse.Status
+func (r ClockOutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClockOutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAreasWithResponse request returning *ListAreasResponse
+func (c *ClientWithResponses) ListAreasWithResponse(ctx context.Context, params *ListAreasParams, reqEditors ...RequestEditorFn) (*ListAreasResponse, error) {
+	rsp, err := c.ListAreas(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAreasResponse(rsp)
+}
+
+// AddDispatchWithBodyWithResponse request with arbitrary body returning *AddDispatchResponse
+func (c *ClientWithResponses) AddDispatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDispatchResponse, error) {
+	rsp, err := c.AddDispatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDispatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDispatchWithFormdataBodyWithResponse(ctx context.Context, body AddDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*AddDispatchResponse, error) {
+	rsp, err := c.AddDispatchWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDispatchResponse(rsp)
+}
+
+// CloseDispatchWithResponse request returning *CloseDispatchResponse
+func (c *ClientWithResponses) CloseDispatchWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*CloseDispatchResponse, error) {
+	rsp, err := c.CloseDispatch(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseDispatchResponse(rsp)
+}
+
+// OpenDispatchWithBodyWithResponse request with arbitrary body returning *OpenDispatchResponse
+func (c *ClientWithResponses) OpenDispatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OpenDispatchResponse, error) {
+	rsp, err := c.OpenDispatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOpenDispatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) OpenDispatchWithFormdataBodyWithResponse(ctx context.Context, body OpenDispatchFormdataRequestBody, reqEditors ...RequestEditorFn) (*OpenDispatchResponse, error) {
+	rsp, err := c.OpenDispatchWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOpenDispatchResponse(rsp)
+}
+
+// ListDispatchTypesWithResponse request returning *ListDispatchTypesResponse
+func (c *ClientWithResponses) ListDispatchTypesWithResponse(ctx context.Context, params *ListDispatchTypesParams, reqEditors ...RequestEditorFn) (*ListDispatchTypesResponse, error) {
+	rsp, err := c.ListDispatchTypes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDispatchTypesResponse(rsp)
+}
+
+// ListLinesWithResponse request returning *ListLinesResponse
+func (c *ClientWithResponses) ListLinesWithResponse(ctx context.Context, params *ListLinesParams, reqEditors ...RequestEditorFn) (*ListLinesResponse, error) {
+	rsp, err := c.ListLines(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLinesResponse(rsp)
+}
+
+// ListMachinesWithResponse request returning *ListMachinesResponse
+func (c *ClientWithResponses) ListMachinesWithResponse(ctx context.Context, params *ListMachinesParams, reqEditors ...RequestEditorFn) (*ListMachinesResponse, error) {
+	rsp, err := c.ListMachines(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMachinesResponse(rsp)
+}
+
+// IncrementMachineCycleCountWithBodyWithResponse request with arbitrary body returning *IncrementMachineCycleCountResponse
+func (c *ClientWithResponses) IncrementMachineCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IncrementMachineCycleCountResponse, error) {
+	rsp, err := c.IncrementMachineCycleCountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIncrementMachineCycleCountResponse(rsp)
+}
+
+func (c *ClientWithResponses) IncrementMachineCycleCountWithFormdataBodyWithResponse(ctx context.Context, body IncrementMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*IncrementMachineCycleCountResponse, error) {
+	rsp, err := c.IncrementMachineCycleCountWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIncrementMachineCycleCountResponse(rsp)
+}
+
+// SetMachineCycleCountWithBodyWithResponse request with arbitrary body returning *SetMachineCycleCountResponse
+func (c *ClientWithResponses) SetMachineCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMachineCycleCountResponse, error) {
+	rsp, err := c.SetMachineCycleCountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMachineCycleCountResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetMachineCycleCountWithFormdataBodyWithResponse(ctx context.Context, body SetMachineCycleCountFormdataRequestBody, reqEditors ...RequestEditorFn) (*SetMachineCycleCountResponse, error) {
+	rsp, err := c.SetMachineCycleCountWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMachineCycleCountResponse(rsp)
+}
+
+// GetPitchDetailsWithResponse request returning *GetPitchDetailsResponse
+func (c *ClientWithResponses) GetPitchDetailsWithResponse(ctx context.Context, params *GetPitchDetailsParams, reqEditors ...RequestEditorFn) (*GetPitchDetailsResponse, error) {
+	rsp, err := c.GetPitchDetails(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPitchDetailsResponse(rsp)
+}
+
+// RecordPitchDetailsWithBodyWithResponse request with arbitrary body returning *RecordPitchDetailsResponse
+func (c *ClientWithResponses) RecordPitchDetailsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordPitchDetailsResponse, error) {
+	rsp, err := c.RecordPitchDetailsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordPitchDetailsResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecordPitchDetailsWithFormdataBodyWithResponse(ctx context.Context, body RecordPitchDetailsFormdataRequestBody, reqEditors ...RequestEditorFn) (*RecordPitchDetailsResponse, error) {
+	rsp, err := c.RecordPitchDetailsWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordPitchDetailsResponse(rsp)
+}
+
+// ListSitesWithResponse request returning *ListSitesResponse
+func (c *ClientWithResponses) ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error) {
+	rsp, err := c.ListSites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSitesResponse(rsp)
+}
+
+// ClockInWithBodyWithResponse request with arbitrary body returning *ClockInResponse
+func (c *ClientWithResponses) ClockInWithBodyWithResponse(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClockInResponse, error) {
+	rsp, err := c.ClockInWithBody(ctx, user, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClockInResponse(rsp)
+}
+
+func (c *ClientWithResponses) ClockInWithFormdataBodyWithResponse(ctx context.Context, user string, body ClockInFormdataRequestBody, reqEditors ...RequestEditorFn) (*ClockInResponse, error) {
+	rsp, err := c.ClockInWithFormdataBody(ctx, user, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClockInResponse(rsp)
+}
+
+// ClockOutWithBodyWithResponse request with arbitrary body returning *ClockOutResponse
+func (c *ClientWithResponses) ClockOutWithBodyWithResponse(ctx context.Context, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClockOutResponse, error) {
+	rsp, err := c.ClockOutWithBody(ctx, user, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClockOutResponse(rsp)
+}
+
+func (c *ClientWithResponses) ClockOutWithFormdataBodyWithResponse(ctx context.Context, user string, body ClockOutFormdataRequestBody, reqEditors ...RequestEditorFn) (*ClockOutResponse, error) {
+	rsp, err := c.ClockOutWithFormdataBody(ctx, user, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClockOutResponse(rsp)
+}
+
+// ParseListAreasResponse parses an HTTP response from a ListAreasWithResponse call
+func ParseListAreasResponse(rsp *http.Response) (*ListAreasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAreasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AreaList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddDispatchResponse parses an HTTP response from a AddDispatchWithResponse call
+func ParseAddDispatchResponse(rsp *http.Response) (*AddDispatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddDispatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DispatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseDispatchResponse parses an HTTP response from a CloseDispatchWithResponse call
+func ParseCloseDispatchResponse(rsp *http.Response) (*CloseDispatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseDispatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DispatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOpenDispatchResponse parses an HTTP response from a OpenDispatchWithResponse call
+func ParseOpenDispatchResponse(rsp *http.Response) (*OpenDispatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OpenDispatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DispatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDispatchTypesResponse parses an HTTP response from a ListDispatchTypesWithResponse call
+func ParseListDispatchTypesResponse(rsp *http.Response) (*ListDispatchTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDispatchTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DispatchTypeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLinesResponse parses an HTTP response from a ListLinesWithResponse call
+func ParseListLinesResponse(rsp *http.Response) (*ListLinesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLinesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMachinesResponse parses an HTTP response from a ListMachinesWithResponse call
+func ParseListMachinesResponse(rsp *http.Response) (*ListMachinesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMachinesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MachineList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseIncrementMachineCycleCountResponse parses an HTTP response from a IncrementMachineCycleCountWithResponse call
+func ParseIncrementMachineCycleCountResponse(rsp *http.Response) (*IncrementMachineCycleCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IncrementMachineCycleCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Empty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetMachineCycleCountResponse parses an HTTP response from a SetMachineCycleCountWithResponse call
+func ParseSetMachineCycleCountResponse(rsp *http.Response) (*SetMachineCycleCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetMachineCycleCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Empty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPitchDetailsResponse parses an HTTP response from a GetPitchDetailsWithResponse call
+func ParseGetPitchDetailsResponse(rsp *http.Response) (*GetPitchDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPitchDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PitchDetailsSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRecordPitchDetailsResponse parses an HTTP response from a RecordPitchDetailsWithResponse call
+func ParseRecordPitchDetailsResponse(rsp *http.Response) (*RecordPitchDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecordPitchDetailsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PitchDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSitesResponse parses an HTTP response from a ListSitesWithResponse call
+func ParseListSitesResponse(rsp *http.Response) (*ListSitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseClockInResponse parses an HTTP response from a ClockInWithResponse call
+func ParseClockInResponse(rsp *http.Response) (*ClockInResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClockInResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Empty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseClockOutResponse parses an HTTP response from a ClockOutWithResponse call
+func ParseClockOutResponse(rsp *http.Response) (*ClockOutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClockOutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Empty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
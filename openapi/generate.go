@@ -0,0 +1,10 @@
+// Package openapi holds the OpenAPI spec for the subset of the Dispatch
+// API this SDK covers, and the generated low-level client in gen/.
+//
+// To add a new endpoint: add it to dispatch.yaml, run `go generate`, then
+// expose a thin wrapper method on dispatch.Client (see dispatch.Client.ListSites
+// for the pattern: retries, pagination and form-encoding live on the
+// wrapper, not in the generated code).
+package openapi
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 -config codegen-config.yaml dispatch.yaml